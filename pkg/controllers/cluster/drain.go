@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// mirrorPodAnnotation and staticPodSourceAnnotation mark pods that the kubelet manages directly from
+// a local manifest rather than the API server; kubectl drain skips both, and so do we.
+const (
+	mirrorPodAnnotation       = "kubernetes.io/config.mirror"
+	podSourceAnnotation       = "kubernetes.io/config.source"
+	staticPodSourceAnnotation = "file"
+	daemonSetControllerKind   = "DaemonSet"
+)
+
+// DrainPolicy configures a kubectl-drain-style pre-check that processNextWorkItem runs against the
+// target member cluster before invoking ReconcileFunc, so eviction respects PodDisruptionBudgets
+// instead of blindly deleting workloads.
+type DrainPolicy struct {
+	// PodsForBinding returns the pods currently backing the ResourceBinding identified by key on the
+	// named member cluster, along with the PodDisruptionBudgets in effect for that cluster/namespace.
+	PodsForBinding func(clusterName string, key interface{}) ([]*corev1.Pod, []*policyv1.PodDisruptionBudget, error)
+
+	// GracePeriod is how long a pod is given to settle in before it is considered safe to drain.
+	// NewEvictionWorker overwrites this with EvictionQueueOptions.EvictionGracePeriod (the
+	// --eviction-grace-period flag), so callers constructing a DrainPolicy by hand don't need to set it.
+	GracePeriod time.Duration
+
+	// BackoffBase and BackoffMax bound the exponential backoff applied, via AddAfter, each time the drain
+	// pre-check blocks an item, whether a pod is still within GracePeriod or a PDB currently forbids
+	// disrupting it. This backoff is independent of the queue's rate limiter, so blocked items don't
+	// consume the rate-limiter budget meant for eviction pacing.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// isDrainSkippable reports whether pod is a DaemonSet, mirror, or static pod, which kubectl drain
+// leaves in place because deleting them either has no effect or fights the kubelet that recreates them.
+func isDrainSkippable(pod *corev1.Pod) bool {
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return true
+	}
+	if pod.Annotations[podSourceAnnotation] == staticPodSourceAnnotation {
+		return true
+	}
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == daemonSetControllerKind {
+			return true
+		}
+	}
+	return false
+}
+
+// pdbCoversPod reports whether pdb's selector matches pod in the same namespace.
+func pdbCoversPod(pdb *policyv1.PodDisruptionBudget, pod *corev1.Pod) bool {
+	if pdb.Namespace != pod.Namespace {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+// drainBlocked runs the PDB-aware pre-check for key and reports whether any pod backing it is not yet
+// safe to drain, either because it hasn't cleared GracePeriod yet or because a PodDisruptionBudget
+// currently reports zero allowed disruptions. The second return value distinguishes the two: it is
+// true only when the block is caused by a PodDisruptionBudget, so callers can attribute metrics (and
+// backoff) to the right cause instead of treating every grace-period wait as a PDB denial.
+func (w *evictionWorker) drainBlocked(key interface{}) (blocked bool, blockedByPDB bool, err error) {
+	if w.drainPolicy == nil || w.resourceKindFunc == nil {
+		return false, false, nil
+	}
+
+	clusterName, _ := w.resourceKindFunc(key)
+	pods, pdbs, err := w.drainPolicy.PodsForBinding(clusterName, key)
+	if err != nil {
+		return false, false, err
+	}
+
+	now := time.Now()
+	for _, pod := range pods {
+		if isDrainSkippable(pod) {
+			continue
+		}
+		if now.Sub(pod.CreationTimestamp.Time) < w.drainPolicy.GracePeriod {
+			return true, false, nil
+		}
+		for _, pdb := range pdbs {
+			if pdbCoversPod(pdb, pod) && pdb.Status.DisruptionsAllowed <= 0 {
+				return true, true, nil
+			}
+		}
+	}
+	return false, false, nil
+}
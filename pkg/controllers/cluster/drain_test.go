@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsDrainSkippable(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "regular pod",
+			pod:  &corev1.Pod{},
+			want: false,
+		},
+		{
+			name: "daemonset pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet"}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "mirror pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{mirrorPodAnnotation: "hash"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "static pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{podSourceAnnotation: staticPodSourceAnnotation},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDrainSkippable(tt.pod); got != tt.want {
+				t.Errorf("isDrainSkippable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPdbCoversPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+	}
+
+	matching := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	if !pdbCoversPod(matching, pod) {
+		t.Errorf("expected matching PDB to cover pod")
+	}
+
+	wrongNamespace := matching.DeepCopy()
+	wrongNamespace.Namespace = "other"
+	if pdbCoversPod(wrongNamespace, pod) {
+		t.Errorf("expected PDB in a different namespace not to cover pod")
+	}
+
+	wrongSelector := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+		},
+	}
+	if pdbCoversPod(wrongSelector, pod) {
+		t.Errorf("expected non-matching selector not to cover pod")
+	}
+
+	emptySelector := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{},
+		},
+	}
+	if !pdbCoversPod(emptySelector, pod) {
+		t.Errorf("expected PDB with an empty selector to cover every pod in its namespace")
+	}
+}
+
+func TestDrainBlocked(t *testing.T) {
+	settledPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Labels:            map[string]string{"app": "web"},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+	}
+	freshPod := settledPod.DeepCopy()
+	freshPod.CreationTimestamp = metav1.NewTime(time.Now())
+
+	blockingPDB := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+	}
+	clearPDB := blockingPDB.DeepCopy()
+	clearPDB.Status.DisruptionsAllowed = 1
+
+	tests := []struct {
+		name             string
+		pods             []*corev1.Pod
+		pdbs             []*policyv1.PodDisruptionBudget
+		want             bool
+		wantBlockedByPDB bool
+		wantErr          bool
+	}{
+		{
+			name: "settled pod with no blocking PDB is not blocked",
+			pods: []*corev1.Pod{settledPod},
+			pdbs: []*policyv1.PodDisruptionBudget{clearPDB},
+			want: false,
+		},
+		{
+			name:             "pod still within grace period and no PDB present is blocked but not by a PDB",
+			pods:             []*corev1.Pod{freshPod},
+			pdbs:             nil,
+			want:             true,
+			wantBlockedByPDB: false,
+		},
+		{
+			name:             "pod still within grace period is blocked",
+			pods:             []*corev1.Pod{freshPod},
+			pdbs:             []*policyv1.PodDisruptionBudget{clearPDB},
+			want:             true,
+			wantBlockedByPDB: false,
+		},
+		{
+			name:             "pod covered by a PDB with zero disruptions allowed is blocked by the PDB",
+			pods:             []*corev1.Pod{settledPod},
+			pdbs:             []*policyv1.PodDisruptionBudget{blockingPDB},
+			want:             true,
+			wantBlockedByPDB: true,
+		},
+		{
+			name: "skippable pods are ignored regardless of grace period or PDBs",
+			pods: []*corev1.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(time.Now()),
+				Annotations:       map[string]string{mirrorPodAnnotation: "hash"},
+			}}},
+			pdbs: []*policyv1.PodDisruptionBudget{blockingPDB},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &evictionWorker{
+				name: "test",
+				drainPolicy: &DrainPolicy{
+					PodsForBinding: func(string, interface{}) ([]*corev1.Pod, []*policyv1.PodDisruptionBudget, error) {
+						return tt.pods, tt.pdbs, nil
+					},
+					GracePeriod: 5 * time.Minute,
+				},
+				resourceKindFunc: func(interface{}) (string, string) { return "member1", "Deployment" },
+			}
+
+			got, blockedByPDB, err := w.drainBlocked("key")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("drainBlocked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("drainBlocked() blocked = %v, want %v", got, tt.want)
+			}
+			if blockedByPDB != tt.wantBlockedByPDB {
+				t.Errorf("drainBlocked() blockedByPDB = %v, want %v", blockedByPDB, tt.wantBlockedByPDB)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package cluster
 
 import (
+	"sort"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -37,21 +39,41 @@ const maxEvictionDelay = 1000 * time.Second
 // DynamicRateLimiter adjusts its rate based on the overall health of clusters.
 // It implements the workqueue.RateLimiter interface with dynamic behavior.
 type DynamicRateLimiter[T comparable] struct {
+	// queueName identifies this limiter's queue on the eviction-threshold-tier metrics, so that
+	// multiple DynamicRateLimiter instances active in the same process (e.g. this package's and
+	// gracefuleviction's) don't clobber each other's gauge values.
+	queueName                     string
 	resourceEvictionRate          float32
 	secondaryResourceEvictionRate float32
 	unhealthyClusterThreshold     float32
 	largeClusterNumThreshold      int
 	informerManager               genericmanager.SingleClusterInformerManager
+
+	// softEvictionThresholds is a ladder of tiered thresholds, sorted ascending by ratio, that
+	// takes over rate selection from unhealthyClusterThreshold/largeClusterNumThreshold when non-empty.
+	softEvictionThresholds []config.SoftEvictionThreshold
+	// observedSinceMu guards observedSince.
+	observedSinceMu sync.Mutex
+	// observedSince records, per soft threshold, when its ratio was first continuously observed.
+	// A threshold is removed once the ratio falls back below it.
+	observedSince map[config.SoftEvictionThreshold]time.Time
 }
 
-// NewDynamicRateLimiter creates a new DynamicRateLimiter with the given options.
-func NewDynamicRateLimiter[T comparable](informerManager genericmanager.SingleClusterInformerManager, opts config.EvictionQueueOptions) workqueue.TypedRateLimiter[T] {
+// NewDynamicRateLimiter creates a new DynamicRateLimiter with the given options. queueName identifies
+// this limiter on the eviction-threshold-tier metrics.
+func NewDynamicRateLimiter[T comparable](queueName string, informerManager genericmanager.SingleClusterInformerManager, opts config.EvictionQueueOptions) workqueue.TypedRateLimiter[T] {
+	sortedThresholds := append([]config.SoftEvictionThreshold(nil), opts.SoftEvictionThresholds...)
+	sort.Slice(sortedThresholds, func(i, j int) bool { return sortedThresholds[i].Ratio < sortedThresholds[j].Ratio })
+
 	return &DynamicRateLimiter[T]{
+		queueName:                     queueName,
 		resourceEvictionRate:          opts.ResourceEvictionRate,
 		secondaryResourceEvictionRate: opts.SecondaryResourceEvictionRate,
 		unhealthyClusterThreshold:     opts.UnhealthyClusterThreshold,
 		largeClusterNumThreshold:      opts.LargeClusterNumThreshold,
 		informerManager:               informerManager,
+		softEvictionThresholds:        sortedThresholds,
+		observedSince:                 make(map[config.SoftEvictionThreshold]time.Time),
 	}
 }
 
@@ -104,6 +126,10 @@ func (d *DynamicRateLimiter[T]) getCurrentRate() float32 {
 	failureRate := float32(unhealthyClusters) / float32(totalClusters)
 	metrics.RecordClusterHealthMetrics(unhealthyClusters, float64(failureRate))
 
+	if len(d.softEvictionThresholds) > 0 {
+		return d.resolveTieredRate(failureRate)
+	}
+
 	// Determine rate based on health status
 	isUnhealthy := failureRate > d.unhealthyClusterThreshold
 	if !isUnhealthy {
@@ -121,6 +147,55 @@ func (d *DynamicRateLimiter[T]) getCurrentRate() float32 {
 	return 0
 }
 
+// resolveTieredRate walks the soft/hard eviction threshold ladder (ascending by ratio) and returns
+// the rate of the highest tier that is currently active. A soft tier only becomes active once its
+// ratio has been continuously observed for at least its grace period; a hard tier is active as soon
+// as its ratio is crossed. Tiers whose ratio the current failure rate has fallen back below have their
+// observation timestamp cleared, so a later crossing starts the grace period from zero again.
+func (d *DynamicRateLimiter[T]) resolveTieredRate(failureRate float32) float32 {
+	d.observedSinceMu.Lock()
+	defer d.observedSinceMu.Unlock()
+
+	now := time.Now()
+	activeRate := d.resourceEvictionRate
+	activeTier := float32(-1)
+	observedAge := time.Duration(0)
+
+	for _, threshold := range d.softEvictionThresholds {
+		if failureRate < threshold.Ratio {
+			delete(d.observedSince, threshold)
+			continue
+		}
+
+		if threshold.Hard {
+			activeRate = threshold.Rate
+			activeTier = threshold.Ratio
+			observedAge = 0
+			continue
+		}
+
+		firstObserved, ok := d.observedSince[threshold]
+		if !ok {
+			firstObserved = now
+			d.observedSince[threshold] = now
+		}
+
+		age := now.Sub(firstObserved)
+		if age >= threshold.GracePeriod {
+			activeRate = threshold.Rate
+			activeTier = threshold.Ratio
+			observedAge = age
+		}
+	}
+
+	metrics.RecordEvictionThresholdTierMetrics(d.queueName, float64(activeTier), observedAge.Seconds())
+	if activeTier >= 0 {
+		klog.V(2).Infof("System failure rate %.2f crossed into eviction tier %.2f (observed for %s), rate now %.2f/s",
+			failureRate, activeTier, observedAge, activeRate)
+	}
+	return activeRate
+}
+
 // Forget is a no-op as this rate limiter doesn't track individual items.
 func (d *DynamicRateLimiter[T]) Forget(item T) {
 	// No-op
@@ -133,13 +208,15 @@ func (d *DynamicRateLimiter[T]) NumRequeues(item T) int {
 
 // NewGracefulEvictionRateLimiter creates a combined rate limiter for eviction.
 // It uses the maximum delay from both dynamic and default rate limiters to ensure
-// both cluster health and retry backoff are considered.
+// both cluster health and retry backoff are considered. queueName identifies this limiter on the
+// eviction-threshold-tier metrics.
 func NewGracefulEvictionRateLimiter[T comparable](
+	queueName string,
 	informerManager genericmanager.SingleClusterInformerManager,
 	evictionOpts config.EvictionQueueOptions,
 	rateLimiterOpts ratelimiterflag.Options) workqueue.TypedRateLimiter[T] {
 
-	dynamicLimiter := NewDynamicRateLimiter[T](informerManager, evictionOpts)
+	dynamicLimiter := NewDynamicRateLimiter[T](queueName, informerManager, evictionOpts)
 	defaultLimiter := ratelimiterflag.DefaultControllerRateLimiter[T](rateLimiterOpts)
 	return workqueue.NewTypedMaxOfRateLimiter[T](dynamicLimiter, defaultLimiter)
 }
@@ -20,6 +20,9 @@ import (
 	"context"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
@@ -30,19 +33,58 @@ import (
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 )
 
+const (
+	// PreventEvictionAnnotation opts a resource out of automated eviction entirely when set to "true".
+	// It is a runtime break-glass for operators, requiring no controller flag or restart to take effect.
+	PreventEvictionAnnotation = "eviction.karmada.io/prevent-eviction"
+
+	// TaintedTimestampAnnotation records when a resource first became eligible for eviction. EvictionWorker
+	// stamps it once and never overwrites it, so with PersistAnnotations set it survives controller
+	// restarts; EvictionWorker itself does not read it back or compute how long a resource has been pending.
+	TaintedTimestampAnnotation = "eviction.karmada.io/tainted-timestamp"
+
+	// ReasonAnnotation is a human-readable description of why a resource was queued for eviction,
+	// supplied by the caller via EnqueueWithReason.
+	ReasonAnnotation = "eviction.karmada.io/reason"
+)
+
 // EvictionWorker enhances AsyncWorker with dynamic rate limiting and metrics
 // for eviction operations. It provides a queue that adjusts its processing rate
 // based on cluster health status.
 type EvictionWorker interface {
 	util.AsyncWorker
+
+	// EnqueueWithReason behaves like Enqueue, but also stamps ReasonAnnotation with reason so operators
+	// can see why a resource was queued. Callers that already know why they're enqueueing (typically a
+	// reconciler) should prefer this over Enqueue.
+	EnqueueWithReason(obj interface{}, reason string)
 }
 
 type evictionWorker struct {
-	name             string
-	keyFunc          util.KeyFunc
-	reconcileFunc    util.ReconcileFunc
-	resourceKindFunc func(key interface{}) (clusterName, resourceKind string)
-	queue            workqueue.TypedRateLimitingInterface[any]
+	name               string
+	keyFunc            util.KeyFunc
+	reconcileFunc      util.ReconcileFunc
+	resourceKindFunc   func(key interface{}) (clusterName, resourceKind string)
+	persistAnnotations func(obj interface{}, annotations map[string]string) error
+	annotationsForKey  func(key interface{}) (map[string]string, error)
+	queue              workqueue.TypedRateLimitingInterface[any]
+	leaderElection     *LeaderElectionConfig
+	drainPolicy        *DrainPolicy
+	pdbBackoff         workqueue.TypedRateLimiter[any]
+}
+
+// LeaderElectionConfig gates an EvictionWorker's worker goroutines on holding a lease scoped to the
+// queue's name, so that distinct eviction queues can be led by different replicas of an HA
+// karmada-controller-manager instead of racing to process the same item.
+type LeaderElectionConfig struct {
+	// Lock is the resource lock campaigned for and held to gain leadership over this queue.
+	Lock resourcelock.Interface
+	// LeaseDuration is the duration non-leader candidates wait before attempting to acquire leadership.
+	LeaseDuration time.Duration
+	// RenewDeadline is how long the current leader tries to renew its lease before giving it up.
+	RenewDeadline time.Duration
+	// RetryPeriod is how long candidates wait between attempts to acquire or renew leadership.
+	RetryPeriod time.Duration
 }
 
 // EvictionWorkerOptions configures a new EvictionWorker instance.
@@ -59,6 +101,23 @@ type EvictionWorkerOptions struct {
 	// ResourceKindFunc returns resource metadata for metrics collection
 	ResourceKindFunc func(key interface{}) (clusterName, resourceKind string)
 
+	// PersistAnnotations, when set, is called with the full desired annotation set whenever Enqueue or
+	// EnqueueWithReason stamps TaintedTimestampAnnotation or ReasonAnnotation, so the caller can write
+	// them back to the resource in the API server (e.g. via a client Update or Patch call). obj is the
+	// same object passed to Enqueue/EnqueueWithReason; annotations is never nil. When PersistAnnotations
+	// is nil, stamped annotations are not persisted anywhere and do not survive a cache refresh or a
+	// controller restart.
+	PersistAnnotations func(obj interface{}, annotations map[string]string) error
+
+	// AnnotationsForKey, when set, is called with a queued key immediately before ReconcileFunc runs so
+	// processNextWorkItem can re-check PreventEvictionAnnotation against the resource's *current*
+	// annotations rather than the snapshot Enqueue saw. A key requeued after a failed ReconcileFunc waits
+	// out the queue's rate limiter, which is maxEvictionDelay per retry while DynamicRateLimiter's rate is
+	// zero; without this, an operator adding the break-glass annotation during an incident would have
+	// no effect on anything already queued. Callers whose ReconcileFunc already re-fetches the object for
+	// other reasons can instead call EvictionPrevented themselves and skip setting this.
+	AnnotationsForKey func(key interface{}) (map[string]string, error)
+
 	// InformerManager provides cluster information for dynamic rate limiting
 	InformerManager genericmanager.SingleClusterInformerManager
 
@@ -67,29 +126,66 @@ type EvictionWorkerOptions struct {
 
 	// RateLimiterOptions configures general rate limiter behavior
 	RateLimiterOptions ratelimiterflag.Options
+
+	// LeaderElection, when set, gates worker goroutines on holding a lease before they pull from the
+	// queue, and re-parks them without shutting down the queue whenever the lease is lost.
+	LeaderElection *LeaderElectionConfig
+
+	// DrainPolicy, when set, makes processNextWorkItem run a kubectl-drain-style PDB pre-check against
+	// the target member cluster before invoking ReconcileFunc, instead of evicting unconditionally.
+	DrainPolicy *DrainPolicy
 }
 
 // NewEvictionWorker creates a new EvictionWorker with dynamic rate limiting.
 func NewEvictionWorker(opts EvictionWorkerOptions) EvictionWorker {
 	rateLimiter := NewGracefulEvictionRateLimiter[interface{}](
+		opts.Name,
 		opts.InformerManager,
 		opts.EvictionQueueOptions,
 		opts.RateLimiterOptions,
 	)
 
+	var pdbBackoff workqueue.TypedRateLimiter[any]
+	drainPolicy := opts.DrainPolicy
+	if drainPolicy != nil {
+		// Copy rather than mutate opts.DrainPolicy in place: it's the caller's struct, and GracePeriod is
+		// sourced from EvictionQueueOptions.EvictionGracePeriod (the --eviction-grace-period flag) here so
+		// callers don't each have to remember to set it themselves.
+		withGracePeriod := *drainPolicy
+		withGracePeriod.GracePeriod = opts.EvictionQueueOptions.EvictionGracePeriod
+		drainPolicy = &withGracePeriod
+		pdbBackoff = workqueue.NewTypedItemExponentialFailureRateLimiter[any](drainPolicy.BackoffBase, drainPolicy.BackoffMax)
+	}
+
 	return &evictionWorker{
-		name:             opts.Name,
-		keyFunc:          opts.KeyFunc,
-		reconcileFunc:    opts.ReconcileFunc,
-		resourceKindFunc: opts.ResourceKindFunc,
+		name:               opts.Name,
+		keyFunc:            opts.KeyFunc,
+		reconcileFunc:      opts.ReconcileFunc,
+		resourceKindFunc:   opts.ResourceKindFunc,
+		persistAnnotations: opts.PersistAnnotations,
+		annotationsForKey:  opts.AnnotationsForKey,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig[any](rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
 			Name: opts.Name,
 		}),
+		leaderElection: opts.LeaderElection,
+		drainPolicy:    drainPolicy,
+		pdbBackoff:     pdbBackoff,
 	}
 }
 
 // Enqueue converts an object to a key and adds it to the queue.
 func (w *evictionWorker) Enqueue(obj interface{}) {
+	w.EnqueueWithReason(obj, "")
+}
+
+// EnqueueWithReason behaves like Enqueue, but also stamps ReasonAnnotation with reason so operators can
+// see why a resource was queued.
+func (w *evictionWorker) EnqueueWithReason(obj interface{}, reason string) {
+	if w.evictionPrevented(obj) {
+		return
+	}
+	w.stampAnnotations(obj, reason)
+
 	key, err := w.keyFunc(obj)
 	if err != nil {
 		klog.Errorf("Failed to generate key for obj: %+v, err: %v", obj, err)
@@ -103,7 +199,75 @@ func (w *evictionWorker) Enqueue(obj interface{}) {
 	w.Add(key)
 }
 
-// Add puts an item into the queue and updates metrics.
+// EvictionPrevented reports whether annotations carries the PreventEvictionAnnotation break-glass
+// annotation set to "true". It's exported so a caller's ReconcileFunc, which typically re-fetches the
+// object for its own purposes anyway, can run the same check against whatever it fetched instead of
+// configuring AnnotationsForKey to have EvictionWorker fetch it a second time.
+func EvictionPrevented(annotations map[string]string) bool {
+	return annotations[PreventEvictionAnnotation] == "true"
+}
+
+// evictionPrevented reports whether item carries the PreventEvictionAnnotation break-glass annotation,
+// and records a prevented_total metric when it does.
+func (w *evictionWorker) evictionPrevented(item interface{}) bool {
+	accessor, err := meta.Accessor(item)
+	if err != nil {
+		return false
+	}
+
+	if !EvictionPrevented(accessor.GetAnnotations()) {
+		return false
+	}
+
+	klog.V(2).Infof("Skipping eviction of %s due to %s annotation", accessor.GetName(), PreventEvictionAnnotation)
+	metrics.RecordEvictionPreventedMetrics(w.name, "prevent-eviction-annotation")
+	return true
+}
+
+// stampAnnotations computes TaintedTimestampAnnotation (set once, the first time obj becomes eligible
+// for eviction) and, when reason is non-empty, ReasonAnnotation for obj, and hands the resulting
+// annotation set to PersistAnnotations. It never mutates obj itself: obj is typically a cached object
+// shared with every other consumer of the same informer, and obj's in-memory copy of the annotations
+// won't be read back by anything once Enqueue returns, so mutating it in place would only risk
+// corrupting the shared cache for no benefit.
+func (w *evictionWorker) stampAnnotations(obj interface{}, reason string) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	existing := accessor.GetAnnotations()
+	desired := make(map[string]string, len(existing)+2)
+	for k, v := range existing {
+		desired[k] = v
+	}
+
+	changed := false
+	if _, ok := desired[TaintedTimestampAnnotation]; !ok {
+		desired[TaintedTimestampAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		changed = true
+	}
+	if reason != "" && desired[ReasonAnnotation] != reason {
+		desired[ReasonAnnotation] = reason
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	if w.persistAnnotations == nil {
+		klog.V(4).Infof("No PersistAnnotations configured for eviction worker %s; annotations for %s will not be written back", w.name, accessor.GetName())
+		return
+	}
+
+	if err := w.persistAnnotations(obj, desired); err != nil {
+		klog.Errorf("Failed to persist eviction annotations for %s on eviction worker %s: %v", accessor.GetName(), w.name, err)
+	}
+}
+
+// Add puts an item into the queue and updates metrics. Break-glass prevention is enforced in Enqueue,
+// where item is still the original annotated object; by the time Add is called, item is a queue key and
+// no longer carries annotations for evictionPrevented to inspect.
 func (w *evictionWorker) Add(item interface{}) {
 	if item == nil {
 		klog.Warningf("Ignore nil item from queue")
@@ -143,24 +307,93 @@ func (w *evictionWorker) worker(ctx context.Context) {
 	}
 }
 
+// evictionWakeupToken is pushed into the queue by OnStoppedLeading, once per worker goroutine started
+// for the ending term, to unblock any of them parked in the blocking queue.Get() call below. workqueue's
+// Get() takes no context and only returns when an item is available or the queue shuts down, so without
+// this a worker parked on an idle queue would never notice its term's context was canceled.
+//
+// Each token must be a distinct value: workqueue's queue is set-backed, so adding the same item while
+// an earlier copy of it is still queued collapses both into a single entry, which would wake up fewer
+// workers than intended. A pointer to a non-empty struct guarantees every token is its own allocation.
+//
+// term identifies which election term the wakeup is scoped to (the leaderCtx workers of that term run
+// with). workqueue.Get() hands a token to whichever goroutine calls it next, regardless of which term
+// started it, so a new term campaigning back in right after this one loses its lease could otherwise
+// have one of its freshly started workers steal a wakeup meant for a still-parked worker of the old
+// term. Tagging the token lets processNextWorkItem tell the two cases apart and put back any wakeup it
+// isn't the intended recipient of, instead of silently discarding it.
+type evictionWakeupToken struct {
+	term context.Context
+}
+
 // processNextWorkItem handles a single item from the queue with metrics tracking.
-// Returns false when the queue is shutting down, true otherwise.
-func (w *evictionWorker) processNextWorkItem(_ context.Context) bool {
+// Returns false when the queue is shutting down, or when ctx is done (e.g. leadership was lost),
+// in either case putting back any item it already pulled so the next leader can pick it up.
+func (w *evictionWorker) processNextWorkItem(ctx context.Context) bool {
 	key, quit := w.queue.Get()
 	if quit {
 		return false
 	}
+
+	if token, ok := key.(*evictionWakeupToken); ok {
+		w.queue.Done(key)
+		if token.term != ctx {
+			// Scoped to a different term than the one this worker is running under — whether because a
+			// new term's worker raced a stale term's worker for it, or any other mismatch. Put it back
+			// so the worker it actually belongs to can still find it, rather than silently consuming a
+			// wakeup that isn't this worker's to consume.
+			w.queue.Add(key)
+		}
+		// A wakeup scoped to this worker's own term only needed to unblock this Get() call: a worker
+		// whose ctx is still live (the wakeup arrived before its term actually ended) goes back to
+		// Get(), while a worker whose ctx was actually canceled exits.
+		return ctx.Err() == nil
+	}
 	defer w.queue.Done(key)
 
+	select {
+	case <-ctx.Done():
+		w.queue.AddRateLimited(key)
+		return false
+	default:
+	}
+
 	// Update queue metrics
 	metrics.RecordEvictionQueueMetrics(w.name, float64(w.queue.Len()))
 
+	if w.drainPolicy != nil {
+		blocked, blockedByPDB, err := w.drainBlocked(key)
+		if err != nil {
+			klog.Errorf("Drain pre-check failed for %v: %v", key, err)
+		} else if blocked {
+			if blockedByPDB {
+				metrics.RecordEvictionBlockedByPDBMetrics(w.name)
+			}
+			w.queue.AddAfter(key, w.pdbBackoff.When(key))
+			return true
+		}
+		w.pdbBackoff.Forget(key)
+	}
+
 	// Get resource metadata for metrics
 	var clusterName, resourceKind string
 	if w.resourceKindFunc != nil {
 		clusterName, resourceKind = w.resourceKindFunc(key)
 	}
 
+	if w.annotationsForKey != nil {
+		annotations, err := w.annotationsForKey(key)
+		if err != nil {
+			klog.Errorf("Failed to re-check eviction annotations for %v: %v", key, err)
+		} else if EvictionPrevented(annotations) {
+			klog.V(2).Infof("Skipping eviction of %v: %s was set after enqueue", key, PreventEvictionAnnotation)
+			metrics.RecordEvictionPreventedMetrics(w.name, "prevent-eviction-annotation")
+			w.queue.Forget(key)
+			metrics.RecordEvictionKindMetrics(clusterName, resourceKind, false)
+			return true
+		}
+	}
+
 	// Process the item and measure latency
 	startTime := time.Now()
 	err := w.reconcileFunc(key)
@@ -182,11 +415,15 @@ func (w *evictionWorker) processNextWorkItem(_ context.Context) bool {
 	return true
 }
 
-// Run starts worker goroutines and ensures cleanup when context is canceled.
+// Run starts worker goroutines and ensures cleanup when context is canceled. When LeaderElection is
+// configured, worker goroutines only begin pulling from the queue once this instance holds the lease;
+// on lease loss they drain their in-flight item and stop, re-parking until leadership is regained,
+// without shutting down the queue itself.
 func (w *evictionWorker) Run(ctx context.Context, workerNumber int) {
-	klog.Infof("Starting %d workers for eviction worker %s", workerNumber, w.name)
-	for i := 0; i < workerNumber; i++ {
-		go w.worker(ctx)
+	if w.leaderElection == nil {
+		w.startWorkers(ctx, workerNumber)
+	} else {
+		go w.runWithLeaderElection(ctx, workerNumber)
 	}
 
 	// Clean up when context is canceled
@@ -196,3 +433,59 @@ func (w *evictionWorker) Run(ctx context.Context, workerNumber int) {
 		w.queue.ShutDown()
 	}()
 }
+
+// startWorkers launches workerNumber worker goroutines bound to ctx.
+func (w *evictionWorker) startWorkers(ctx context.Context, workerNumber int) {
+	klog.Infof("Starting %d workers for eviction worker %s", workerNumber, w.name)
+	for i := 0; i < workerNumber; i++ {
+		go w.worker(ctx)
+	}
+}
+
+// runWithLeaderElection repeatedly campaigns for the queue's lease and only runs worker goroutines
+// while holding it. Each election term gets its own derived context so that losing the lease cancels
+// exactly the workers started for that term, without tearing down the underlying queue.
+func (w *evictionWorker) runWithLeaderElection(ctx context.Context, workerNumber int) {
+	for ctx.Err() == nil {
+		termCtx, cancelTerm := context.WithCancel(ctx)
+
+		// leaderCtx is stamped onto this term's wakeup tokens below, so it must be captured from
+		// OnStartedLeading: it's the context leaderelection derives from termCtx and actually hands to
+		// startWorkers, not termCtx itself, and processNextWorkItem compares a token's term against the
+		// exact ctx each worker goroutine runs with.
+		var leaderCtx context.Context
+
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:          w.leaderElection.Lock,
+			LeaseDuration: w.leaderElection.LeaseDuration,
+			RenewDeadline: w.leaderElection.RenewDeadline,
+			RetryPeriod:   w.leaderElection.RetryPeriod,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(startedCtx context.Context) {
+					klog.Infof("Eviction worker %s acquired leadership, starting %d workers", w.name, workerNumber)
+					leaderCtx = startedCtx
+					w.startWorkers(startedCtx, workerNumber)
+				},
+				OnStoppedLeading: func() {
+					klog.Infof("Eviction worker %s lost leadership, draining in-flight work and re-parking", w.name)
+					cancelTerm()
+					// Wake up every worker started for the ending term so none of them stays parked in
+					// queue.Get() indefinitely on an idle queue. Tagging each token with leaderCtx lets
+					// processNextWorkItem tell this term's wakeups apart from a subsequent term's, so a
+					// worker started for a new term racing in right after this one can't steal one.
+					for i := 0; i < workerNumber; i++ {
+						w.queue.Add(&evictionWakeupToken{term: leaderCtx})
+					}
+				},
+			},
+		})
+		if err != nil {
+			klog.Errorf("Failed to create leader elector for eviction worker %s: %v", w.name, err)
+			cancelTerm()
+			return
+		}
+
+		elector.Run(termCtx)
+		cancelTerm()
+	}
+}
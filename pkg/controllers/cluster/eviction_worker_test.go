@@ -0,0 +1,327 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func newTestEvictionWorker() *evictionWorker {
+	return &evictionWorker{
+		name: "test",
+		keyFunc: func(obj interface{}) (interface{}, error) {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return nil, err
+			}
+			return accessor.GetName(), nil
+		},
+		queue: workqueue.NewTypedRateLimitingQueue[any](workqueue.DefaultTypedControllerRateLimiter[any]()),
+	}
+}
+
+func TestEvictionPrevented(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	unannotated := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	if w.evictionPrevented(unannotated) {
+		t.Errorf("expected pod without PreventEvictionAnnotation not to be prevented")
+	}
+
+	prevented := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "pod",
+		Annotations: map[string]string{PreventEvictionAnnotation: "true"},
+	}}
+	if !w.evictionPrevented(prevented) {
+		t.Errorf("expected pod with PreventEvictionAnnotation=true to be prevented")
+	}
+}
+
+func TestEnqueueWithReasonStampsAnnotationsWithoutMutatingObj(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	var persistedObj interface{}
+	var persistedAnnotations map[string]string
+	calls := 0
+	w.persistAnnotations = func(obj interface{}, annotations map[string]string) error {
+		calls++
+		persistedObj = obj
+		persistedAnnotations = annotations
+		return nil
+	}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	w.EnqueueWithReason(pod, "cluster unreachable")
+
+	if calls != 1 {
+		t.Fatalf("expected PersistAnnotations to be called once, got %d", calls)
+	}
+	if persistedObj != pod {
+		t.Errorf("expected PersistAnnotations to be called with the original obj")
+	}
+	if _, ok := persistedAnnotations[TaintedTimestampAnnotation]; !ok {
+		t.Errorf("expected persisted annotations to include %s", TaintedTimestampAnnotation)
+	}
+	if got := persistedAnnotations[ReasonAnnotation]; got != "cluster unreachable" {
+		t.Errorf("expected persisted %s to be %q, got %q", ReasonAnnotation, "cluster unreachable", got)
+	}
+	if len(pod.Annotations) != 0 {
+		t.Errorf("expected the original obj's annotations to be left untouched, got %v", pod.Annotations)
+	}
+
+	if w.queue.Len() != 1 {
+		t.Errorf("expected the key to still be queued, queue length = %d", w.queue.Len())
+	}
+}
+
+func TestEnqueueWithReasonSkipsUnchangedAnnotations(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	calls := 0
+	w.persistAnnotations = func(obj interface{}, annotations map[string]string) error {
+		calls++
+		return nil
+	}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "pod",
+		Annotations: map[string]string{
+			TaintedTimestampAnnotation: "2020-01-01T00:00:00Z",
+			ReasonAnnotation:           "cluster unreachable",
+		},
+	}}
+	w.EnqueueWithReason(pod, "cluster unreachable")
+
+	if calls != 0 {
+		t.Errorf("expected PersistAnnotations not to be called when nothing changed, got %d calls", calls)
+	}
+	if w.queue.Len() != 1 {
+		t.Errorf("expected the key to still be queued, queue length = %d", w.queue.Len())
+	}
+}
+
+func TestEnqueuePreventedSkipsQueueAndPersist(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	calls := 0
+	w.persistAnnotations = func(obj interface{}, annotations map[string]string) error {
+		calls++
+		return nil
+	}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "pod",
+		Annotations: map[string]string{PreventEvictionAnnotation: "true"},
+	}}
+	w.Enqueue(pod)
+
+	if calls != 0 {
+		t.Errorf("expected PersistAnnotations not to be called for a prevented resource, got %d calls", calls)
+	}
+	if w.queue.Len() != 0 {
+		t.Errorf("expected a prevented resource not to be queued, queue length = %d", w.queue.Len())
+	}
+}
+
+func TestProcessNextWorkItemWakeupUnblocksCanceledWorkerOnly(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	const workerNumber = 3
+	liveCtx := context.Background()
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(workerNumber)
+	for i := 0; i < workerNumber; i++ {
+		go func() {
+			defer wg.Done()
+			for w.processNextWorkItem(canceledCtx) {
+			}
+		}()
+	}
+
+	for i := 0; i < workerNumber; i++ {
+		w.queue.Add(&evictionWakeupToken{term: canceledCtx})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("workers with a canceled context did not exit after receiving wakeups; goroutine leak")
+	}
+
+	// A worker still bound to a live context should shrug off a wakeup scoped to a different term and
+	// keep waiting for real work instead of exiting.
+	w.queue.Add(&evictionWakeupToken{term: canceledCtx})
+	liveDone := make(chan bool, 1)
+	go func() {
+		liveDone <- w.processNextWorkItem(liveCtx)
+	}()
+
+	select {
+	case stillRunning := <-liveDone:
+		if !stillRunning {
+			t.Fatal("expected a worker on a live context to keep running after a foreign-term wakeup")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker on a live context never processed the wakeup")
+	}
+}
+
+// TestProcessNextWorkItemWakeupDoesNotLeakAcrossTerms guards against a new term's workers racing a
+// stale term's parked workers for the same wakeup tokens and swallowing one meant for the stale term,
+// which would leave that stale-term worker blocked in queue.Get() forever.
+func TestProcessNextWorkItemWakeupDoesNotLeakAcrossTerms(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	const workerNumber = 3
+	staleCtx, cancelStale := context.WithCancel(context.Background())
+	cancelStale()
+	newCtx := context.Background()
+
+	var staleWG sync.WaitGroup
+	staleWG.Add(workerNumber)
+	for i := 0; i < workerNumber; i++ {
+		go func() {
+			defer staleWG.Done()
+			for w.processNextWorkItem(staleCtx) {
+			}
+		}()
+	}
+
+	// Start the new term's workers racing for the same queue before the stale term's wakeups land, the
+	// way a replica re-acquiring leadership right after a transient renew failure would.
+	var newWG sync.WaitGroup
+	newWG.Add(workerNumber)
+	for i := 0; i < workerNumber; i++ {
+		go func() {
+			defer newWG.Done()
+			for w.processNextWorkItem(newCtx) {
+			}
+		}()
+	}
+
+	for i := 0; i < workerNumber; i++ {
+		w.queue.Add(&evictionWakeupToken{term: staleCtx})
+	}
+
+	staleDone := make(chan struct{})
+	go func() {
+		staleWG.Wait()
+		close(staleDone)
+	}()
+
+	select {
+	case <-staleDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale-term workers did not all exit; a new-term worker likely stole one of their wakeups")
+	}
+
+	// Nothing is left to wake the new term's workers except a queue shutdown.
+	w.queue.ShutDown()
+	newDone := make(chan struct{})
+	go func() {
+		newWG.Wait()
+		close(newDone)
+	}()
+
+	select {
+	case <-newDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("new-term workers did not exit after queue shutdown")
+	}
+}
+
+func TestProcessNextWorkItemReChecksPreventEvictionBeforeReconcile(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	reconcileCalls := 0
+	w.reconcileFunc = func(key interface{}) error {
+		reconcileCalls++
+		return nil
+	}
+	w.resourceKindFunc = func(key interface{}) (string, string) { return "member1", "Deployment" }
+	// The annotation wasn't present when the key was enqueued; annotationsForKey simulates it having
+	// been added afterward, while the key was sitting in the queue under a DynamicRateLimiter delay.
+	w.annotationsForKey = func(key interface{}) (map[string]string, error) {
+		return map[string]string{PreventEvictionAnnotation: "true"}, nil
+	}
+
+	w.queue.Add("late-prevented-key")
+	if !w.processNextWorkItem(context.Background()) {
+		t.Fatal("expected processNextWorkItem to report more work remains")
+	}
+
+	if reconcileCalls != 0 {
+		t.Errorf("expected ReconcileFunc not to run once PreventEvictionAnnotation was re-checked, got %d calls", reconcileCalls)
+	}
+	if w.queue.Len() != 0 {
+		t.Errorf("expected the prevented key to be dropped rather than requeued, queue length = %d", w.queue.Len())
+	}
+}
+
+func TestProcessNextWorkItemPDBBlockedRequeuesWithoutReconciling(t *testing.T) {
+	w := newTestEvictionWorker()
+
+	reconcileCalls := 0
+	w.reconcileFunc = func(key interface{}) error {
+		reconcileCalls++
+		return nil
+	}
+	w.resourceKindFunc = func(key interface{}) (string, string) { return "member1", "Deployment" }
+	w.drainPolicy = &DrainPolicy{
+		PodsForBinding: func(string, interface{}) ([]*corev1.Pod, []*policyv1.PodDisruptionBudget, error) {
+			return []*corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}},
+				[]*policyv1.PodDisruptionBudget{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{}},
+					Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+				}}, nil
+		},
+		BackoffBase: time.Millisecond,
+		BackoffMax:  time.Second,
+	}
+	w.pdbBackoff = workqueue.NewTypedItemExponentialFailureRateLimiter[any](w.drainPolicy.BackoffBase, w.drainPolicy.BackoffMax)
+
+	w.queue.Add("blocked-key")
+	if !w.processNextWorkItem(context.Background()) {
+		t.Fatal("expected processNextWorkItem to report more work remains")
+	}
+
+	if reconcileCalls != 0 {
+		t.Errorf("expected ReconcileFunc not to run while PDB-blocked, got %d calls", reconcileCalls)
+	}
+	if w.queue.Len() != 0 {
+		t.Errorf("expected the blocked key to be scheduled via AddAfter rather than sitting ready, queue length = %d", w.queue.Len())
+	}
+}
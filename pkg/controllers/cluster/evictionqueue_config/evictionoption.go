@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// EvictionQueueOptions holds the options for the dynamic eviction rate limiter.
+// These options are used to control the behavior of the eviction queue based on the overall health of the clusters.
+type EvictionQueueOptions struct {
+	// ResourceEvictionRate is the number of resources to be evicted per second.
+	// This is the default rate when the system is considered healthy.
+	ResourceEvictionRate float32
+	// SecondaryResourceEvictionRate is the secondary resource eviction rate.
+	// When the number of cluster failures in the Karmada instance exceeds the unhealthy-cluster-threshold,
+	// the resource eviction rate will be reduced to this secondary level.
+	SecondaryResourceEvictionRate float32
+	// UnhealthyClusterThreshold is the threshold of unhealthy clusters.
+	// If the ratio of unhealthy clusters to total clusters exceeds this threshold, the Karmada instance is considered unhealthy,
+	// and the eviction rate will be downgraded to the secondary rate.
+	UnhealthyClusterThreshold float32
+	// LargeClusterNumThreshold is the threshold for a large-scale Karmada instance.
+	// When the number of clusters in the instance exceeds this threshold and the instance is unhealthy,
+	// the eviction rate is downgraded. For smaller instances that are unhealthy, eviction might be halted completely.
+	LargeClusterNumThreshold int
+	// SoftEvictionThresholds is an ordered ladder of tiered eviction thresholds, modeled on the kubelet
+	// eviction manager's soft/hard threshold model. Each tier only takes effect once the unhealthy-cluster
+	// ratio has remained at or above its Ratio for at least GracePeriod, which prevents transient cluster
+	// blips from flapping the eviction rate. A tier with Hard set bypasses the grace period entirely.
+	// When empty, UnhealthyClusterThreshold and LargeClusterNumThreshold continue to govern the rate.
+	SoftEvictionThresholds []SoftEvictionThreshold
+	// EvictionGracePeriod is how long a pod is given to settle in before it is considered safe to
+	// drain, mirroring the grace-period semantics of `kubectl drain --pod-selector`.
+	EvictionGracePeriod time.Duration
+}
+
+// AddFlags adds flags for the EvictionQueueOptions to the specified FlagSet.
+func (o *EvictionQueueOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	fs.Float32Var(&o.ResourceEvictionRate, "resource-eviction-rate", 0.5, "The number of resources to be evicted per second.")
+	fs.Float32Var(&o.SecondaryResourceEvictionRate, "secondary-resource-eviction-rate", 0.1, "The secondary resource eviction rate when the Karmada instance is unhealthy.")
+	fs.Float32Var(&o.UnhealthyClusterThreshold, "unhealthy-cluster-threshold", 0.55, "The unhealthy threshold of the cluster, if the ratio of unhealthy clusters to total clusters exceeds thisthreshold, the Karmada instance is considered unhealthy.")
+	fs.IntVar(&o.LargeClusterNumThreshold, "large-cluster-num-threshold", 10, "The large-scale threshold of the Karmada instance. When the number of clusters in a large-scale federation exceedsthis threshold and the federation is unhealthy, the resource eviction rate will be reduced; otherwise, the eviction will be stopped.")
+	fs.Var(newSoftEvictionThresholdValue(&o.SoftEvictionThresholds, false), "eviction-soft-threshold",
+		"Repeatable. Adds a tiered soft eviction threshold as ratio=<float>,gracePeriod=<duration>,rate=<float>, mirroring the kubelet eviction manager's soft threshold model. May be specified multiple times to build a ladder of increasingly aggressive tiers.")
+	fs.Var(newSoftEvictionThresholdValue(&o.SoftEvictionThresholds, true), "eviction-hard-threshold",
+		"Repeatable. Adds a hard eviction threshold as ratio=<float>,rate=<float> that takes effect immediately, bypassing any grace period, for catastrophic cluster failures.")
+	fs.DurationVar(&o.EvictionGracePeriod, "eviction-grace-period", 0, "The grace period given to a pod before it is considered safe to drain as part of the PDB-aware pre-check, mirroring kubectl drain's grace-period semantics.")
+}
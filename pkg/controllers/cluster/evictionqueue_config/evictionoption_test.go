@@ -32,6 +32,9 @@ func TestEvictionControllerOptions_AddFlags(t *testing.T) {
 		"secondary-resource-eviction-rate",
 		"unhealthy-cluster-threshold",
 		"large-cluster-num-threshold",
+		"eviction-soft-threshold",
+		"eviction-hard-threshold",
+		"eviction-grace-period",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SoftEvictionThreshold describes a single tier in the soft/hard eviction threshold ladder.
+// Soft tiers only take effect once the unhealthy-cluster ratio has remained at or above Ratio
+// for at least GracePeriod; hard tiers apply immediately.
+type SoftEvictionThreshold struct {
+	// Ratio is the unhealthy-cluster ratio at or above which this tier becomes eligible.
+	Ratio float32
+	// GracePeriod is how long Ratio must be continuously observed before Rate is applied.
+	// Ignored when Hard is true.
+	GracePeriod time.Duration
+	// Rate is the resource eviction rate applied once this tier is active.
+	Rate float32
+	// Hard marks a threshold that bypasses the grace period entirely, for catastrophic failures.
+	Hard bool
+}
+
+// String renders the threshold in the same key=value form accepted by the --eviction-soft-threshold
+// and --eviction-hard-threshold flags.
+func (t SoftEvictionThreshold) String() string {
+	if t.Hard {
+		return fmt.Sprintf("ratio=%.2f,rate=%.2f", t.Ratio, t.Rate)
+	}
+	return fmt.Sprintf("ratio=%.2f,gracePeriod=%s,rate=%.2f", t.Ratio, t.GracePeriod, t.Rate)
+}
+
+// softEvictionThresholdValue is a pflag.Value that appends each occurrence of a repeatable
+// --eviction-soft-threshold/--eviction-hard-threshold flag onto the shared SoftEvictionThresholds slice.
+type softEvictionThresholdValue struct {
+	thresholds *[]SoftEvictionThreshold
+	hard       bool
+}
+
+func newSoftEvictionThresholdValue(thresholds *[]SoftEvictionThreshold, hard bool) *softEvictionThresholdValue {
+	return &softEvictionThresholdValue{thresholds: thresholds, hard: hard}
+}
+
+// String returns the most recently appended threshold of this flag's kind, as required by pflag.Value.
+func (v *softEvictionThresholdValue) String() string {
+	for i := len(*v.thresholds) - 1; i >= 0; i-- {
+		if (*v.thresholds)[i].Hard == v.hard {
+			return (*v.thresholds)[i].String()
+		}
+	}
+	return ""
+}
+
+// Set parses a ratio=<float>,gracePeriod=<duration>,rate=<float> entry and appends it to the slice.
+func (v *softEvictionThresholdValue) Set(value string) error {
+	threshold := SoftEvictionThreshold{Hard: v.hard}
+	seen := map[string]bool{}
+
+	for _, field := range strings.Split(value, ",") {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid eviction threshold field %q, expected key=value", field)
+		}
+		key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "ratio":
+			ratio, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return fmt.Errorf("invalid ratio %q: %v", val, err)
+			}
+			threshold.Ratio = float32(ratio)
+		case "gracePeriod":
+			if v.hard {
+				return fmt.Errorf("gracePeriod is not valid for a hard eviction threshold")
+			}
+			gracePeriod, err := time.ParseDuration(val)
+			if err != nil {
+				return fmt.Errorf("invalid gracePeriod %q: %v", val, err)
+			}
+			threshold.GracePeriod = gracePeriod
+		case "rate":
+			rate, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return fmt.Errorf("invalid rate %q: %v", val, err)
+			}
+			threshold.Rate = float32(rate)
+		default:
+			return fmt.Errorf("unknown eviction threshold field %q", key)
+		}
+		seen[key] = true
+	}
+
+	if !seen["ratio"] || !seen["rate"] {
+		return fmt.Errorf("eviction threshold %q must set both ratio and rate", value)
+	}
+
+	*v.thresholds = append(*v.thresholds, threshold)
+	return nil
+}
+
+// Type implements pflag.Value.
+func (v *softEvictionThresholdValue) Type() string {
+	return "softEvictionThreshold"
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// UtilizationThreshold holds per-resource utilization ratios used to classify member clusters by
+// node utilization, mirroring the thresholds used by descheduler-style LowNodeUtilization plugins.
+type UtilizationThreshold struct {
+	// CPU is the allocatable-CPU request ratio for this threshold.
+	CPU float64
+	// Memory is the allocatable-memory request ratio for this threshold.
+	Memory float64
+	// Pods is the allocatable-pods ratio for this threshold.
+	Pods float64
+}
+
+// UtilizationEvictionOptions configures the utilization-driven eviction trigger, an alternative to
+// the unhealthy-cluster-ratio model configured by EvictionQueueOptions: clusters are evicted from
+// based on node resource imbalance rather than cluster health.
+type UtilizationEvictionOptions struct {
+	// LowThreshold marks a cluster as underutilized when every resource dimension is below it.
+	LowThreshold UtilizationThreshold
+	// HighThreshold marks a cluster as overutilized when any resource dimension is at or above it.
+	HighThreshold UtilizationThreshold
+}
+
+// AddFlags adds flags for the UtilizationEvictionOptions to the specified FlagSet.
+func (o *UtilizationEvictionOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	fs.Float64Var(&o.LowThreshold.CPU, "utilization-low-threshold-cpu", 0.2, "The allocatable-CPU request ratio below which a member cluster is considered underutilized.")
+	fs.Float64Var(&o.LowThreshold.Memory, "utilization-low-threshold-memory", 0.2, "The allocatable-memory request ratio below which a member cluster is considered underutilized.")
+	fs.Float64Var(&o.LowThreshold.Pods, "utilization-low-threshold-pods", 0.2, "The allocatable-pods ratio below which a member cluster is considered underutilized.")
+	fs.Float64Var(&o.HighThreshold.CPU, "utilization-high-threshold-cpu", 0.5, "The allocatable-CPU request ratio at or above which a member cluster is considered overutilized.")
+	fs.Float64Var(&o.HighThreshold.Memory, "utilization-high-threshold-memory", 0.5, "The allocatable-memory request ratio at or above which a member cluster is considered overutilized.")
+	fs.Float64Var(&o.HighThreshold.Pods, "utilization-high-threshold-pods", 0.5, "The allocatable-pods ratio at or above which a member cluster is considered overutilized.")
+}
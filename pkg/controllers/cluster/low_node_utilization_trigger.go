@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	config "github.com/karmada-io/karmada/pkg/controllers/cluster/evictionqueue_config"
+	"github.com/karmada-io/karmada/pkg/metrics"
+)
+
+// TriggerPolicy is an alternative eviction trigger to DynamicRateLimiter's unhealthy-cluster-ratio
+// model: rather than gating the rate at which an existing queue drains, it actively enqueues eviction
+// candidates whenever it judges the federation's resource placement imbalanced.
+type TriggerPolicy interface {
+	// Reconcile runs one evaluation cycle, enqueuing eviction candidates onto the trigger's queue.
+	Reconcile() error
+}
+
+// ClusterResourceUsage is a member cluster's aggregated node allocatable capacity and current
+// requests, used to classify it as under/appropriately/over-utilized.
+type ClusterResourceUsage struct {
+	ClusterName     string
+	AllocatableCPU  resource.Quantity
+	RequestedCPU    resource.Quantity
+	AllocatableMem  resource.Quantity
+	RequestedMem    resource.Quantity
+	AllocatablePods int64
+	RequestedPods   int64
+}
+
+// EvictionCandidate is a ResourceBinding placed on an overutilized cluster that is eligible to
+// migrate elsewhere.
+type EvictionCandidate struct {
+	// Key is the object handed to the target EvictionWorker's Enqueue, which derives the queue key from
+	// it via its KeyFunc.
+	Key interface{}
+	// Priority is the ResourceBinding's scheduling priority; lower values are evicted first.
+	Priority int32
+	// RequestedCPU is the total CPU requested by the ResourceBinding's pods on the cluster.
+	RequestedCPU resource.Quantity
+}
+
+// clusterUtilization classifies a member cluster relative to LowNodeUtilizationTrigger's thresholds.
+type clusterUtilization int
+
+const (
+	underutilized clusterUtilization = iota
+	appropriatelyUtilized
+	overutilized
+)
+
+// LowNodeUtilizationTrigger classifies member clusters by node utilization, inspired by Volcano's
+// rescheduling plugin of the same name, and feeds migration candidates from overutilized clusters
+// into an EvictionWorker queue until the CPU enqueued reaches the smaller of the overutilized clusters'
+// excess and the underutilized clusters' headroom (see Reconcile).
+//
+// Migration sizing and candidate ordering are driven by CPU: it is the dimension Kubernetes workloads
+// request most consistently, while memory and pod-count thresholds are still honored for classifying
+// clusters as under/over-utilized.
+type LowNodeUtilizationTrigger struct {
+	LowThreshold  config.UtilizationThreshold
+	HighThreshold config.UtilizationThreshold
+
+	// ClusterUsage returns a resource-usage snapshot for every member cluster, aggregated from node
+	// metrics via the informer manager.
+	ClusterUsage func() ([]ClusterResourceUsage, error)
+
+	// CandidatesOnCluster returns the ResourceBindings currently placed on clusterName.
+	CandidatesOnCluster func(clusterName string) ([]EvictionCandidate, error)
+
+	// Queue receives every selected eviction candidate.
+	Queue EvictionWorker
+}
+
+// NewLowNodeUtilizationTrigger creates a LowNodeUtilizationTrigger from the given options.
+func NewLowNodeUtilizationTrigger(
+	opts config.UtilizationEvictionOptions,
+	queue EvictionWorker,
+	clusterUsage func() ([]ClusterResourceUsage, error),
+	candidatesOnCluster func(clusterName string) ([]EvictionCandidate, error),
+) *LowNodeUtilizationTrigger {
+	return &LowNodeUtilizationTrigger{
+		LowThreshold:        opts.LowThreshold,
+		HighThreshold:       opts.HighThreshold,
+		ClusterUsage:        clusterUsage,
+		CandidatesOnCluster: candidatesOnCluster,
+		Queue:               queue,
+	}
+}
+
+// classify buckets usage by comparing its per-resource utilization ratios against the trigger's
+// thresholds: underutilized requires every dimension below the low threshold, overutilized requires
+// any dimension at or above the high threshold, everything else is appropriately utilized.
+func (t *LowNodeUtilizationTrigger) classify(usage ClusterResourceUsage) clusterUtilization {
+	cpuRatio := quantityRatio(usage.RequestedCPU, usage.AllocatableCPU)
+	memRatio := quantityRatio(usage.RequestedMem, usage.AllocatableMem)
+	podRatio := float64(0)
+	if usage.AllocatablePods > 0 {
+		podRatio = float64(usage.RequestedPods) / float64(usage.AllocatablePods)
+	}
+
+	if cpuRatio >= t.HighThreshold.CPU || memRatio >= t.HighThreshold.Memory || podRatio >= t.HighThreshold.Pods {
+		return overutilized
+	}
+	if cpuRatio < t.LowThreshold.CPU && memRatio < t.LowThreshold.Memory && podRatio < t.LowThreshold.Pods {
+		return underutilized
+	}
+	return appropriatelyUtilized
+}
+
+// Reconcile classifies every member cluster, computes the CPU that must migrate off overutilized
+// clusters to bring them below the high CPU threshold, caps it at the CPU the underutilized clusters
+// can still absorb below their own high threshold, and enqueues candidates - sorted by priority
+// ascending then requested CPU descending - until the CPU enqueued reaches that capped target. Each
+// candidate is enqueued whole, so the last one can carry the total past the target, and past the
+// underutilized clusters' headroom; no candidate is skipped for being too large.
+func (t *LowNodeUtilizationTrigger) Reconcile() error {
+	usages, err := t.ClusterUsage()
+	if err != nil {
+		return err
+	}
+
+	var underutilizedClusters, overutilizedClusters []ClusterResourceUsage
+	appropriatelyUtilizedCount := 0
+	for _, usage := range usages {
+		switch t.classify(usage) {
+		case underutilized:
+			underutilizedClusters = append(underutilizedClusters, usage)
+		case overutilized:
+			overutilizedClusters = append(overutilizedClusters, usage)
+		default:
+			appropriatelyUtilizedCount++
+		}
+	}
+	metrics.RecordUtilizationClassificationMetrics(len(underutilizedClusters), appropriatelyUtilizedCount, len(overutilizedClusters))
+
+	migrationTarget := excessOverHighCPUThreshold(overutilizedClusters, t.HighThreshold.CPU)
+	headroom := headroomBelowHighCPUThreshold(underutilizedClusters, t.HighThreshold.CPU)
+	if headroom.Cmp(migrationTarget) < 0 {
+		migrationTarget = headroom
+	}
+	metrics.RecordUtilizationMigrationTargetMetrics(migrationTarget.AsApproximateFloat64())
+
+	if migrationTarget.IsZero() {
+		return nil
+	}
+
+	var migrated resource.Quantity
+	for _, cluster := range overutilizedClusters {
+		if migrated.Cmp(migrationTarget) >= 0 {
+			return nil
+		}
+
+		candidates, err := t.CandidatesOnCluster(cluster.ClusterName)
+		if err != nil {
+			klog.Errorf("Failed to list eviction candidates on cluster %s: %v", cluster.ClusterName, err)
+			continue
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].Priority != candidates[j].Priority {
+				return candidates[i].Priority < candidates[j].Priority
+			}
+			return candidates[i].RequestedCPU.Cmp(candidates[j].RequestedCPU) > 0
+		})
+
+		for _, candidate := range candidates {
+			if migrated.Cmp(migrationTarget) >= 0 {
+				break
+			}
+			t.Queue.Enqueue(candidate.Key)
+			migrated.Add(candidate.RequestedCPU)
+		}
+	}
+	return nil
+}
+
+// quantityRatio returns requested/allocatable, or zero when allocatable is zero.
+func quantityRatio(requested, allocatable resource.Quantity) float64 {
+	if allocatable.IsZero() {
+		return 0
+	}
+	return requested.AsApproximateFloat64() / allocatable.AsApproximateFloat64()
+}
+
+// scaledQuantity returns a Quantity equal to q scaled by ratio.
+func scaledQuantity(q resource.Quantity, ratio float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(float64(q.MilliValue())*ratio), q.Format)
+}
+
+// excessOverHighCPUThreshold sums, across clusters, how much requested CPU exceeds what the high
+// threshold allows.
+func excessOverHighCPUThreshold(clusters []ClusterResourceUsage, highCPU float64) resource.Quantity {
+	var total resource.Quantity
+	for _, cluster := range clusters {
+		allowed := scaledQuantity(cluster.AllocatableCPU, highCPU)
+		if cluster.RequestedCPU.Cmp(allowed) > 0 {
+			excess := cluster.RequestedCPU.DeepCopy()
+			excess.Sub(allowed)
+			total.Add(excess)
+		}
+	}
+	return total
+}
+
+// headroomBelowHighCPUThreshold sums, across clusters, how much requested CPU each cluster could
+// still absorb before reaching the high threshold.
+func headroomBelowHighCPUThreshold(clusters []ClusterResourceUsage, highCPU float64) resource.Quantity {
+	var total resource.Quantity
+	for _, cluster := range clusters {
+		allowed := scaledQuantity(cluster.AllocatableCPU, highCPU)
+		if allowed.Cmp(cluster.RequestedCPU) > 0 {
+			remaining := allowed.DeepCopy()
+			remaining.Sub(cluster.RequestedCPU)
+			total.Add(remaining)
+		}
+	}
+	return total
+}
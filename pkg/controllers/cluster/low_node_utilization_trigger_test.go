@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/karmada-io/karmada/pkg/controllers/cluster/evictionqueue_config"
+)
+
+// evictionCandidateKey builds a Key that newTestEvictionWorker's keyFunc (which extracts the name via
+// meta.Accessor) can resolve back to name, since Reconcile hands candidate.Key to Queue.Enqueue as-is.
+func evictionCandidateKey(name string) interface{} {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func cpuQuantity(cores int64) resource.Quantity {
+	return *resource.NewQuantity(cores, resource.DecimalSI)
+}
+
+func TestLowNodeUtilizationTrigger_Classify(t *testing.T) {
+	trigger := &LowNodeUtilizationTrigger{
+		LowThreshold:  config.UtilizationThreshold{CPU: 0.2, Memory: 0.2, Pods: 0.2},
+		HighThreshold: config.UtilizationThreshold{CPU: 0.5, Memory: 0.5, Pods: 0.5},
+	}
+
+	tests := []struct {
+		name  string
+		usage ClusterResourceUsage
+		want  clusterUtilization
+	}{
+		{
+			name: "underutilized",
+			usage: ClusterResourceUsage{
+				AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(10),
+				AllocatableMem: cpuQuantity(100), RequestedMem: cpuQuantity(10),
+				AllocatablePods: 100, RequestedPods: 10,
+			},
+			want: underutilized,
+		},
+		{
+			name: "overutilized by cpu alone",
+			usage: ClusterResourceUsage{
+				AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(60),
+				AllocatableMem: cpuQuantity(100), RequestedMem: cpuQuantity(10),
+				AllocatablePods: 100, RequestedPods: 10,
+			},
+			want: overutilized,
+		},
+		{
+			name: "appropriately utilized",
+			usage: ClusterResourceUsage{
+				AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(30),
+				AllocatableMem: cpuQuantity(100), RequestedMem: cpuQuantity(30),
+				AllocatablePods: 100, RequestedPods: 30,
+			},
+			want: appropriatelyUtilized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trigger.classify(tt.usage); got != tt.want {
+				t.Errorf("classify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcessAndHeadroomOverHighCPUThreshold(t *testing.T) {
+	overutilized := []ClusterResourceUsage{
+		{AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(80)},
+	}
+	excess := excessOverHighCPUThreshold(overutilized, 0.5)
+	if excess.Cmp(cpuQuantity(30)) != 0 {
+		t.Errorf("expected excess of 30 cores, got %v", excess.String())
+	}
+
+	underutilized := []ClusterResourceUsage{
+		{AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(10)},
+	}
+	headroom := headroomBelowHighCPUThreshold(underutilized, 0.5)
+	if headroom.Cmp(cpuQuantity(40)) != 0 {
+		t.Errorf("expected headroom of 40 cores, got %v", headroom.String())
+	}
+}
+
+func TestLowNodeUtilizationTriggerReconcile(t *testing.T) {
+	queue := newTestEvictionWorker()
+
+	// clusterA is overutilized by 30 cores (80 requested against an 0.5*100=50 core high threshold),
+	// clusterB is underutilized with 40 cores of headroom below its own high threshold, so the
+	// migration target is capped at min(30, 40) = 30 cores.
+	clusterUsage := func() ([]ClusterResourceUsage, error) {
+		return []ClusterResourceUsage{
+			{ClusterName: "clusterA", AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(80),
+				AllocatableMem: cpuQuantity(100), RequestedMem: cpuQuantity(10), AllocatablePods: 100, RequestedPods: 10},
+			{ClusterName: "clusterB", AllocatableCPU: cpuQuantity(100), RequestedCPU: cpuQuantity(10),
+				AllocatableMem: cpuQuantity(100), RequestedMem: cpuQuantity(10), AllocatablePods: 100, RequestedPods: 10},
+		}, nil
+	}
+
+	candidatesOnCluster := func(clusterName string) ([]EvictionCandidate, error) {
+		if clusterName != "clusterA" {
+			return nil, nil
+		}
+		// Deliberately out of priority/CPU order, to exercise the sort: expected enqueue order is
+		// "low-priority" (lower Priority wins first), then "high-cpu" over "low-cpu" (same Priority,
+		// higher RequestedCPU wins), then "tie-breaker" only if the cap allows.
+		return []EvictionCandidate{
+			{Key: evictionCandidateKey("low-cpu"), Priority: 1, RequestedCPU: cpuQuantity(5)},
+			{Key: evictionCandidateKey("low-priority"), Priority: 0, RequestedCPU: cpuQuantity(5)},
+			{Key: evictionCandidateKey("high-cpu"), Priority: 1, RequestedCPU: cpuQuantity(20)},
+			{Key: evictionCandidateKey("tie-breaker"), Priority: 1, RequestedCPU: cpuQuantity(20)},
+		}, nil
+	}
+
+	trigger := &LowNodeUtilizationTrigger{
+		LowThreshold:        config.UtilizationThreshold{CPU: 0.2, Memory: 0.2, Pods: 0.2},
+		HighThreshold:       config.UtilizationThreshold{CPU: 0.5, Memory: 0.5, Pods: 0.5},
+		ClusterUsage:        clusterUsage,
+		CandidatesOnCluster: candidatesOnCluster,
+		Queue:               queue,
+	}
+
+	if err := trigger.Reconcile(); err != nil {
+		t.Fatalf("Reconcile() returned error: %v", err)
+	}
+
+	// low-priority (5) + high-cpu (20) = 25 < 30 target, so tie-breaker (20) is also needed to reach
+	// the target, bringing migrated to 45 which meets the 30-core target; low-cpu should never be
+	// enqueued since it sorts last and the target is already met by then.
+	wantOrder := []string{"low-priority", "high-cpu", "tie-breaker"}
+	for i, want := range wantOrder {
+		key, _ := queue.queue.Get()
+		if key != want {
+			t.Fatalf("enqueue #%d = %v, want %v", i, key, want)
+		}
+		queue.queue.Done(key)
+	}
+	if got := queue.queue.Len(); got != 0 {
+		t.Errorf("expected no further candidates enqueued once the migration target was met, got %d more", got)
+	}
+}
@@ -15,6 +15,8 @@ func TestEvictionControllerOptions_AddFlags(t *testing.T) {
 		"secondary-resource-eviction-rate",
 		"unhealthy-cluster-threshold",
 		"large-cluster-num-threshold",
+		"eviction-soft-threshold",
+		"eviction-hard-threshold",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -38,6 +38,12 @@ type GracefulEvictionOptions struct {
 	// When the number of clusters in the instance exceeds this threshold and the instance is unhealthy,
 	// the eviction rate is downgraded. For smaller instances that are unhealthy, eviction might be halted completely.
 	LargeClusterNumThreshold int
+	// SoftEvictionThresholds is an ordered ladder of tiered eviction thresholds, modeled on the kubelet
+	// eviction manager's soft/hard threshold model. Each tier only takes effect once the unhealthy-cluster
+	// ratio has remained at or above its Ratio for at least GracePeriod, which prevents transient cluster
+	// blips from flapping the eviction rate. A tier with Hard set bypasses the grace period entirely.
+	// When empty, UnhealthyClusterThreshold and LargeClusterNumThreshold continue to govern the rate.
+	SoftEvictionThresholds []SoftEvictionThreshold
 }
 
 // AddFlags adds flags for the GracefulEvictionOptions to the specified FlagSet.
@@ -49,4 +55,8 @@ func (o *GracefulEvictionOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.Float32Var(&o.SecondaryResourceEvictionRate, "secondary-resource-eviction-rate", 0.1, "The secondary resource eviction rate when the Karmada instance is unhealthy.")
 	fs.Float32Var(&o.UnhealthyClusterThreshold, "unhealthy-cluster-threshold", 0.55, "The unhealthy threshold of the cluster, if the ratio of unhealthy clusters to total clusters exceeds thisthreshold, the Karmada instance is considered unhealthy.")
 	fs.IntVar(&o.LargeClusterNumThreshold, "large-cluster-num-threshold", 10, "The large-scale threshold of the Karmada instance. When the number of clusters in a large-scale federation exceedsthis threshold and the federation is unhealthy, the resource eviction rate will be reduced; otherwise, the eviction will be stopped.")
+	fs.Var(newSoftEvictionThresholdValue(&o.SoftEvictionThresholds, false), "eviction-soft-threshold",
+		"Repeatable. Adds a tiered soft eviction threshold as ratio=<float>,gracePeriod=<duration>,rate=<float>, mirroring the kubelet eviction manager's soft threshold model. May be specified multiple times to build a ladder of increasingly aggressive tiers.")
+	fs.Var(newSoftEvictionThresholdValue(&o.SoftEvictionThresholds, true), "eviction-hard-threshold",
+		"Repeatable. Adds a hard eviction threshold as ratio=<float>,rate=<float> that takes effect immediately, bypassing any grace period, for catastrophic cluster failures.")
 }
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSoftEvictionThresholdValue_Set(t *testing.T) {
+	var thresholds []SoftEvictionThreshold
+	v := newSoftEvictionThresholdValue(&thresholds, false)
+
+	if err := v.Set("ratio=0.30,gracePeriod=5m,rate=0.3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(thresholds) != 1 {
+		t.Fatalf("expected 1 threshold, got %d", len(thresholds))
+	}
+	if thresholds[0].Ratio != 0.30 || thresholds[0].GracePeriod != 5*time.Minute || thresholds[0].Rate != 0.3 {
+		t.Errorf("unexpected threshold: %+v", thresholds[0])
+	}
+}
+
+func TestSoftEvictionThresholdValue_SetHard(t *testing.T) {
+	var thresholds []SoftEvictionThreshold
+	v := newSoftEvictionThresholdValue(&thresholds, true)
+
+	if err := v.Set("ratio=0.80,rate=0"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(thresholds) != 1 || !thresholds[0].Hard {
+		t.Fatalf("expected a single hard threshold, got %+v", thresholds)
+	}
+
+	if err := v.Set("ratio=0.80,gracePeriod=1m,rate=0"); err == nil {
+		t.Errorf("expected error when gracePeriod is set on a hard threshold")
+	}
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package gracefuleviction
 
 import (
+	"sort"
+	"sync"
 	"time"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	"github.com/karmada-io/karmada/pkg/controllers/gracefuleviction/config"
+	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
@@ -32,21 +35,41 @@ import (
 // DynamicRateLimiter implements a rate limiter that dynamically adjusts its rate
 // based on the overall health of the clusters managed by Karmada.
 type DynamicRateLimiter[T comparable] struct {
+	// queueName identifies this limiter's queue on the eviction-threshold-tier metrics, so that
+	// multiple DynamicRateLimiter instances active in the same process (e.g. this package's and
+	// cluster's) don't clobber each other's gauge values.
+	queueName                     string
 	resourceEvictionRate          float32
 	secondaryResourceEvictionRate float32
 	unhealthyClusterThreshold     float32
 	largeClusterNumThreshold      int
 	informerManager               genericmanager.SingleClusterInformerManager
+
+	// softEvictionThresholds is a ladder of tiered thresholds, sorted ascending by ratio, that
+	// takes over rate selection from unhealthyClusterThreshold/largeClusterNumThreshold when non-empty.
+	softEvictionThresholds []config.SoftEvictionThreshold
+	// observedSinceMu guards observedSince.
+	observedSinceMu sync.Mutex
+	// observedSince records, per soft threshold, when its ratio was first continuously observed.
+	// A threshold is removed once the ratio falls back below it.
+	observedSince map[config.SoftEvictionThreshold]time.Time
 }
 
-// NewDynamicRateLimiter creates a new DynamicRateLimiter with the given options.
-func NewDynamicRateLimiter[T comparable](informerManager genericmanager.SingleClusterInformerManager, opts config.GracefulEvictionOptions) workqueue.TypedRateLimiter[T] {
+// NewDynamicRateLimiter creates a new DynamicRateLimiter with the given options. queueName identifies
+// this limiter on the eviction-threshold-tier metrics.
+func NewDynamicRateLimiter[T comparable](queueName string, informerManager genericmanager.SingleClusterInformerManager, opts config.GracefulEvictionOptions) workqueue.TypedRateLimiter[T] {
+	sortedThresholds := append([]config.SoftEvictionThreshold(nil), opts.SoftEvictionThresholds...)
+	sort.Slice(sortedThresholds, func(i, j int) bool { return sortedThresholds[i].Ratio < sortedThresholds[j].Ratio })
+
 	return &DynamicRateLimiter[T]{
+		queueName:                     queueName,
 		resourceEvictionRate:          opts.ResourceEvictionRate,
 		secondaryResourceEvictionRate: opts.SecondaryResourceEvictionRate,
 		unhealthyClusterThreshold:     opts.UnhealthyClusterThreshold,
 		largeClusterNumThreshold:      opts.LargeClusterNumThreshold,
 		informerManager:               informerManager,
+		softEvictionThresholds:        sortedThresholds,
+		observedSince:                 make(map[config.SoftEvictionThreshold]time.Time),
 	}
 }
 
@@ -92,6 +115,11 @@ func (d *DynamicRateLimiter[T]) getCurrentRate() float32 {
 	}
 
 	failureRate := float32(unhealthyClusters) / float32(totalClusters)
+
+	if len(d.softEvictionThresholds) > 0 {
+		return d.resolveTieredRate(failureRate)
+	}
+
 	isUnhealthy := failureRate > d.unhealthyClusterThreshold
 	if !isUnhealthy {
 		return d.resourceEvictionRate
@@ -108,6 +136,55 @@ func (d *DynamicRateLimiter[T]) getCurrentRate() float32 {
 	return 0
 }
 
+// resolveTieredRate walks the soft/hard eviction threshold ladder (ascending by ratio) and returns
+// the rate of the highest tier that is currently active. A soft tier only becomes active once its
+// ratio has been continuously observed for at least its grace period; a hard tier is active as soon
+// as its ratio is crossed. Tiers whose ratio the current failure rate has fallen back below have their
+// observation timestamp cleared, so a later crossing starts the grace period from zero again.
+func (d *DynamicRateLimiter[T]) resolveTieredRate(failureRate float32) float32 {
+	d.observedSinceMu.Lock()
+	defer d.observedSinceMu.Unlock()
+
+	now := time.Now()
+	activeRate := d.resourceEvictionRate
+	activeTier := float32(-1)
+	observedAge := time.Duration(0)
+
+	for _, threshold := range d.softEvictionThresholds {
+		if failureRate < threshold.Ratio {
+			delete(d.observedSince, threshold)
+			continue
+		}
+
+		if threshold.Hard {
+			activeRate = threshold.Rate
+			activeTier = threshold.Ratio
+			observedAge = 0
+			continue
+		}
+
+		firstObserved, ok := d.observedSince[threshold]
+		if !ok {
+			firstObserved = now
+			d.observedSince[threshold] = now
+		}
+
+		age := now.Sub(firstObserved)
+		if age >= threshold.GracePeriod {
+			activeRate = threshold.Rate
+			activeTier = threshold.Ratio
+			observedAge = age
+		}
+	}
+
+	metrics.RecordEvictionThresholdTierMetrics(d.queueName, float64(activeTier), observedAge.Seconds())
+	if activeTier >= 0 {
+		klog.V(2).Infof("System failure rate %.2f crossed into eviction tier %.2f (observed for %s), rate now %.2f/s",
+			failureRate, activeTier, observedAge, activeRate)
+	}
+	return activeRate
+}
+
 // Forget is called when an item is successfully processed.
 func (d *DynamicRateLimiter[T]) Forget(item T) {
 	// No-op
@@ -119,13 +196,15 @@ func (d *DynamicRateLimiter[T]) NumRequeues(item T) int {
 }
 
 // NewGracefulEvictionRateLimiter creates a rate limiter for graceful eviction controllers
-// It combines the dynamic rate limiter with the default controller rate limiter
+// It combines the dynamic rate limiter with the default controller rate limiter. queueName identifies
+// this limiter on the eviction-threshold-tier metrics.
 func NewGracefulEvictionRateLimiter[T comparable](
+	queueName string,
 	informerManager genericmanager.SingleClusterInformerManager,
 	evictionOpts config.GracefulEvictionOptions,
 	rateLimiterOpts ratelimiterflag.Options) workqueue.TypedRateLimiter[T] {
 
-	dynamicLimiter := NewDynamicRateLimiter[T](informerManager, evictionOpts)
+	dynamicLimiter := NewDynamicRateLimiter[T](queueName, informerManager, evictionOpts)
 	defaultLimiter := ratelimiterflag.DefaultControllerRateLimiter[T](rateLimiterOpts)
 	return workqueue.NewTypedMaxOfRateLimiter[T](dynamicLimiter, defaultLimiter)
 }
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	evictionPreventedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "karmada_eviction_prevented_total",
+		Help: "Total number of eviction attempts skipped due to a break-glass prevention, by reason.",
+	}, []string{"queue", "reason"})
+
+	evictionBlockedByPDBTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "karmada_eviction_blocked_by_pdb_total",
+		Help: "Total number of eviction attempts deferred because a PodDisruptionBudget currently forbids disruption.",
+	}, []string{"queue"})
+)
+
+func init() {
+	prometheus.MustRegister(evictionPreventedTotal, evictionBlockedByPDBTotal)
+}
+
+// RecordEvictionPreventedMetrics records that an eviction was skipped for queue due to reason.
+func RecordEvictionPreventedMetrics(queue, reason string) {
+	evictionPreventedTotal.WithLabelValues(queue, reason).Inc()
+}
+
+// RecordEvictionBlockedByPDBMetrics records that an eviction on queue was deferred by a PodDisruptionBudget.
+func RecordEvictionBlockedByPDBMetrics(queue string) {
+	evictionBlockedByPDBTotal.WithLabelValues(queue).Inc()
+}
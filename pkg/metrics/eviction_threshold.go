@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	evictionThresholdTier = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "karmada_eviction_threshold_tier",
+		Help: "The ratio of the tiered eviction threshold currently in effect for queue, or -1 when none is active.",
+	}, []string{"queue"})
+	evictionThresholdObservedSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "karmada_eviction_threshold_observed_seconds",
+		Help: "How long, in seconds, the currently active tiered eviction threshold for queue has been continuously observed.",
+	}, []string{"queue"})
+)
+
+func init() {
+	prometheus.MustRegister(evictionThresholdTier, evictionThresholdObservedSeconds)
+}
+
+// RecordEvictionThresholdTierMetrics records, for queue, the ratio of the currently active tiered
+// eviction threshold and how long it has been continuously observed. queue distinguishes the
+// independent DynamicRateLimiter instances (e.g. cluster-health eviction vs. graceful eviction) that
+// may be resolving thresholds concurrently in the same controller-manager process.
+func RecordEvictionThresholdTierMetrics(queue string, tier, observedSeconds float64) {
+	evictionThresholdTier.WithLabelValues(queue).Set(tier)
+	evictionThresholdObservedSeconds.WithLabelValues(queue).Set(observedSeconds)
+}
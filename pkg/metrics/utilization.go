@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	utilizationClusterClassification = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "karmada_utilization_cluster_classification",
+		Help: "Number of member clusters in each utilization classification bucket in the most recent reconcile cycle.",
+	}, []string{"classification"})
+
+	utilizationMigrationTargetCPU = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "karmada_utilization_migration_target_cpu_cores",
+		Help: "The amount of CPU the utilization-driven eviction trigger is currently targeting for migration off overutilized clusters.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(utilizationClusterClassification, utilizationMigrationTargetCPU)
+}
+
+// RecordUtilizationClassificationMetrics records how many member clusters fell into each
+// utilization classification bucket in the most recent reconcile cycle.
+func RecordUtilizationClassificationMetrics(underutilized, appropriatelyUtilized, overutilized int) {
+	utilizationClusterClassification.WithLabelValues("underutilized").Set(float64(underutilized))
+	utilizationClusterClassification.WithLabelValues("appropriately_utilized").Set(float64(appropriatelyUtilized))
+	utilizationClusterClassification.WithLabelValues("overutilized").Set(float64(overutilized))
+}
+
+// RecordUtilizationMigrationTargetMetrics records the amount of CPU the utilization-driven eviction
+// trigger is currently targeting for migration off overutilized clusters.
+func RecordUtilizationMigrationTargetMetrics(targetCPU float64) {
+	utilizationMigrationTargetCPU.Set(targetCPU)
+}